@@ -11,6 +11,8 @@ import (
 
 type result string
 
+type loaderKey struct{}
+
 func fetch(keys []string) (map[string]result, error) {
 	fmt.Printf("Batch: %d records requested\n", len(keys))
 	results := make(map[string]result)
@@ -27,24 +29,36 @@ func main() {
 		panic(err)
 	}
 
-	//lint:ignore SA1029 using a string here is fine for demo code
-	doTheThing(context.WithValue(context.Background(), "loader", loader))
+	doTheThing(context.WithValue(context.Background(), loaderKey{}, loader))
 }
 
 func doTheThing(ctx context.Context) {
 	var wg sync.WaitGroup
 
-	loader := ctx.Value("loader").(*dataloader.Loader[string, result])
+	loader := ctx.Value(loaderKey{}).(*dataloader.Loader[string, result])
 
 	for i := 0; i < 10; i++ {
 		wg.Add(1)
 		go func(j int) {
 			defer wg.Done()
+
+			workerCtx := ctx
+			if j == 0 {
+				// Simulate a request whose caller gave up before the batch's
+				// Delay window even fires - LoadCtx returns ctx.Err() instead
+				// of blocking, while the other workers' fetch goes through.
+				var cancel context.CancelFunc
+				workerCtx, cancel = context.WithCancel(ctx)
+				cancel()
+			}
+
 			// Force things into multiple batches
 			time.Sleep(time.Duration(j) * time.Millisecond)
-			result, err := loader.Load(fmt.Sprintf("wat%d", j))
+
+			result, err := loader.LoadCtx(workerCtx, fmt.Sprintf("wat%d", j))
 			if err != nil {
-				panic(err)
+				fmt.Printf("worker %d : %s\n", j, err)
+				return
 			}
 
 			fmt.Printf("worker %d : %s\n", j, result)
@@ -52,4 +66,11 @@ func doTheThing(ctx context.Context) {
 	}
 
 	wg.Wait()
+
+	// LoadManyCtx works the same way, but for a whole set of keys at once.
+	many, errs := loader.LoadManyCtx(ctx, "wat10", "wat11")
+	if len(errs) > 0 {
+		panic(fmt.Sprintf("unexpected errors: %v", errs))
+	}
+	fmt.Printf("many : %v\n", many)
 }
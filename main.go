@@ -66,9 +66,10 @@ ask for details on Los Angeles millions of times, but the library will only
 bother to fetch it once. You are freed from having to deduplicate that yourself.
 
 One super big caveat here. If the multiple requests span multiple batches, the
-data will get fetched multiple times. We do no caching. So if batch size is 1000
-and the details for Los Angeles are requested 10k times, the data will be
-fetched 10 times. It's a little unintuitive but 10 is still better than 10k.
+data will get fetched multiple times by default. So if batch size is 1000 and
+the details for Los Angeles are requested 10k times, the data will be fetched
+10 times. It's a little unintuitive but 10 is still better than 10k. See
+"Caching" below for how to avoid this entirely.
 
 # Shared Loader
 
@@ -76,18 +77,82 @@ One way to use this library is to build a loader for each query. A GraphQL query
 for 'users' happens, we create a loader and do the thing. This helps us, sure,
 but we can take it further.
 
-dataloader does no internal caching and is thread-safe. To gain the benefits of
-batched loading and deduplication for everyone, you can create a loader at start
-time, stick it in a context, and use it wherever. All loads from everywhere will
-be batched together and benefits conferred.
+dataloader is thread-safe. To gain the benefits of batched loading and
+deduplication for everyone, you can create a loader at start time, stick it in
+a context, and use it wherever. All loads from everywhere will be batched
+together and benefits conferred.
 
 This does require a little dance with type instantiation.
 
 See examples/context/main.go for an example.
+
+# Context and cancellation
+
+LoadCtx and LoadManyCtx are context-aware equivalents of Load and LoadMany.
+Each batch carries its own context, derived independently of any one caller's;
+if every caller waiting on a batch has its context cancelled before the Delay
+window expires, the batch is dropped and fetch is never called. A caller whose
+own context is cancelled while the fetch is already in flight gets ctx.Err()
+back immediately - the fetch keeps running for everyone else still waiting on
+it.
+
+FetchFuncCtx is the context-aware fetch signature, used with NewCtx. New still
+works as before; it adapts the non-context FetchFunc so existing callers don't
+need to change anything.
+
+# Caching
+
+By default a Loader does no caching across batches, as described above. Setting
+CacheTTL to a positive duration opts a Loader into a per-loader cache that
+memoizes successful results across batches, so repeated requests for the same
+key never reach fetch again until the entry expires. CacheMaxEntries caps how
+many entries the cache will hold at once; once full, new misses simply aren't
+cached until Clear or ClearAll frees room. Expired entries are not removed on
+their own and still count toward that cap. Prime seeds the cache directly - handy when a
+parent resolver already has the child value in hand - and Clear/ClearAll
+invalidate entries, which you'll want after a mutation changes the underlying
+data.
+
+	loader, err := dataloader.New(fetch)
+	loader.CacheTTL = time.Minute
+
+	loader.Prime("wat", precomputed)
+	result, err := loader.Load("wat") // served from cache, fetch not called
+
+	loader.Clear("wat") // next Load("wat") goes to fetch again
+
+# Bounding load
+
+BatchSize controls how many keys go into a single call to fetch, but by
+default every chunk of a batch fires its fetch concurrently - a batch of 50k
+keys with BatchSize 1000 fires 50 fetches at once. Set MaxConcurrency to cap
+how many of those chunks run at a time, so a big batch can't blow past a
+database connection pool. Set MaxBatch to cap how many distinct keys a single
+batch window will accept at all; once full, further keys spill into the next
+batch rather than growing this one indefinitely.
+
+# One-to-many relationships
+
+Loader is built for the one-to-one case: one key, one value. Plenty of
+GraphQL edges are one-to-many instead - User.posts, Ticket.comments - where a
+single parent key expands to several child rows, typically from one `SELECT *
+FROM posts WHERE user_id IN (?)`. SliceLoader covers that case: its fetch
+function returns map[K][]V instead of map[K]V, and Load returns a slice.
+SliceLoader is just a Loader[K, []V] under the hood, so it gets batching,
+deduplication, chunking, caching, and everything else in this package for
+free.
+
+	func fetchPosts(userIDs []int) (map[int][]Post, error) {
+		// ....
+	}
+
+	loader, err := dataloader.NewSlice(fetchPosts)
+	posts, err := loader.Load(userID)
 */
 package dataloader
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -99,15 +164,41 @@ const (
 
 type FetchFunc[K comparable, V any] func([]K) (map[K]V, error)
 
+// FetchFuncErr is the per-key error variant of FetchFunc. Instead of a single
+// error poisoning every key in the batch, it returns a map of errors keyed by
+// the specific keys that failed, letting callers still get successful results
+// for the keys that didn't.
+type FetchFuncErr[K comparable, V any] func([]K) (map[K]V, map[K]error)
+
+// FetchFuncCtx is the context-aware variant of FetchFunc, used with NewCtx. The
+// context passed through is the batch's own derived context - see LoadCtx.
+type FetchFuncCtx[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// fetchFunc is the internal, fully general fetch signature that every public
+// constructor adapts down to: context-aware and per-key-error-aware.
+type fetchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, map[K]error)
+
 type batch[K comparable, V any] struct {
 	batchSize int
 	ch        chan bool
 	mut       sync.RWMutex
-	fn        FetchFunc[K, V]
+	fn        fetchFunc[K, V]
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	waiters int // live callers still waiting on this batch
 
-	err     error
 	keys    map[K]bool
 	results map[K]V
+	errs    map[K]error
+}
+
+// full reports whether the batch has already accepted maxBatch distinct keys.
+func (bat *batch[K, V]) full(maxBatch int) bool {
+	bat.mut.RLock()
+	defer bat.mut.RUnlock()
+
+	return len(bat.keys) >= maxBatch
 }
 
 // Loader represents an individual loader. BatchSize represents the breakpoint
@@ -120,111 +211,512 @@ type batch[K comparable, V any] struct {
 //
 // If you want to customize BatchSize and Delay, do so immediately after calling
 // New() so every new load will use those values.
+//
+// CacheTTL and CacheMaxEntries opt the Loader into a per-loader cache of
+// successful results, keyed across batches rather than just within one. See
+// "Caching" in the package docs.
+//
+// MaxBatch caps how many distinct keys a single batch will accept; once a
+// batch is full, further keys spill into the next batch window rather than
+// piling onto this one. MaxConcurrency caps how many BatchSize-sized chunks
+// of a single batch are fetched at once, so a large batch can't blow past a
+// downstream connection pool. Both default to 0, meaning unlimited.
 type Loader[K comparable, V any] struct {
-	BatchSize int           // defaults to 1000
-	Delay     time.Duration // defaults to 5 milliseconds
-	fn        FetchFunc[K, V]
+	BatchSize       int           // defaults to 1000
+	Delay           time.Duration // defaults to 5 milliseconds
+	CacheTTL        time.Duration // 0 disables expiry; entries live until cleared
+	CacheMaxEntries int           // 0 means unlimited; also opts into caching on its own
+	MaxBatch        int           // 0 means unlimited keys per batch
+	MaxConcurrency  int           // 0 means unlimited concurrent fetch chunks
+	fn              fetchFunc[K, V]
 
 	mut          sync.Mutex
 	currentBatch *batch[K, V]
+
+	cacheMut sync.RWMutex
+	cache    map[K]cacheEntry[V]
+}
+
+type cacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time // zero value means "never expires"
+}
+
+// cachingEnabled reports whether this Loader has opted into caching, via
+// either CacheTTL or CacheMaxEntries.
+func (loader *Loader[K, V]) cachingEnabled() bool {
+	return loader.CacheTTL > 0 || loader.CacheMaxEntries > 0
+}
+
+// cacheGet returns the cached value for key, if present and not expired.
+func (loader *Loader[K, V]) cacheGet(key K) (V, bool) {
+	loader.cacheMut.RLock()
+	defer loader.cacheMut.RUnlock()
+
+	entry, ok := loader.cache[key]
+	if !ok {
+		return entry.value, false
+	}
+
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		var empty V
+		return empty, false
+	}
+
+	return entry.value, true
+}
+
+// cacheSet stores value for key, subject to CacheMaxEntries. Existing entries
+// are always refreshed; new entries are dropped once the cache is full.
+func (loader *Loader[K, V]) cacheSet(key K, value V) {
+	loader.cacheMut.Lock()
+	defer loader.cacheMut.Unlock()
+
+	if loader.cache == nil {
+		loader.cache = make(map[K]cacheEntry[V])
+	}
+
+	if _, exists := loader.cache[key]; !exists && loader.CacheMaxEntries > 0 && len(loader.cache) >= loader.CacheMaxEntries {
+		return
+	}
+
+	var expiresAt time.Time
+	if loader.CacheTTL > 0 {
+		expiresAt = time.Now().Add(loader.CacheTTL)
+	}
+
+	loader.cache[key] = cacheEntry[V]{value: value, expiresAt: expiresAt}
+}
+
+// Prime seeds the cache with value for key, as though it had been fetched.
+// Useful when a caller already has the value in hand - a parent resolver
+// that embeds the child object, say - and wants to avoid a redundant load.
+func (loader *Loader[K, V]) Prime(key K, value V) {
+	loader.cacheMut.Lock()
+	defer loader.cacheMut.Unlock()
+
+	if loader.cache == nil {
+		loader.cache = make(map[K]cacheEntry[V])
+	}
+
+	var expiresAt time.Time
+	if loader.CacheTTL > 0 {
+		expiresAt = time.Now().Add(loader.CacheTTL)
+	}
+
+	loader.cache[key] = cacheEntry[V]{value: value, expiresAt: expiresAt}
+}
+
+// Clear invalidates the cached entry for key, if any. Call this after a
+// mutation changes the data that key refers to.
+func (loader *Loader[K, V]) Clear(key K) {
+	loader.cacheMut.Lock()
+	defer loader.cacheMut.Unlock()
+
+	delete(loader.cache, key)
+}
+
+// ClearAll invalidates every entry in the cache.
+func (loader *Loader[K, V]) ClearAll() {
+	loader.cacheMut.Lock()
+	defer loader.cacheMut.Unlock()
+
+	loader.cache = nil
+}
+
+// checkCache splits keys into cache hits and cache misses. If caching isn't
+// enabled, every key is reported as a miss.
+func (loader *Loader[K, V]) checkCache(keys []K) (hits map[K]V, misses []K) {
+	if !loader.cachingEnabled() {
+		return nil, keys
+	}
+
+	hits = make(map[K]V)
+	misses = make([]K, 0, len(keys))
+	for _, key := range keys {
+		if value, ok := loader.cacheGet(key); ok {
+			hits[key] = value
+			continue
+		}
+		misses = append(misses, key)
+	}
+
+	return hits, misses
 }
 
 // New generates a new Loader with default BatchSize and Delay.
 func New[K comparable, V any](fn FetchFunc[K, V]) (*Loader[K, V], error) {
+	return newLoader[K, V](wrapFetchFunc[K, V](fn)), nil
+}
+
+// NewWithErrors generates a new Loader with default BatchSize and Delay,
+// using a fetch function that reports errors per key instead of poisoning
+// the whole batch. This lets callers see which specific keys failed while
+// still getting successful results from the same batch.
+func NewWithErrors[K comparable, V any](fn FetchFuncErr[K, V]) (*Loader[K, V], error) {
+	return newLoader[K, V](wrapFetchFuncErr[K, V](fn)), nil
+}
+
+// NewCtx generates a new Loader with default BatchSize and Delay, using a
+// context-aware fetch function. The context passed to fn is the batch's own
+// derived context - see LoadCtx.
+func NewCtx[K comparable, V any](fn FetchFuncCtx[K, V]) (*Loader[K, V], error) {
+	return newLoader[K, V](wrapFetchFuncCtx[K, V](fn)), nil
+}
+
+func newLoader[K comparable, V any](fn fetchFunc[K, V]) *Loader[K, V] {
 	return &Loader[K, V]{
 		BatchSize: defaultBatchSize,
 		Delay:     defaultDelay,
 		fn:        fn,
-	}, nil
+	}
+}
+
+// wrapFetchFunc adapts a FetchFunc to the internal fetchFunc by attributing a
+// batch-wide error to every key that was requested and ignoring context.
+func wrapFetchFunc[K comparable, V any](fn FetchFunc[K, V]) fetchFunc[K, V] {
+	return func(_ context.Context, keys []K) (map[K]V, map[K]error) {
+		results, err := fn(keys)
+		if err != nil {
+			errs := make(map[K]error, len(keys))
+			for _, key := range keys {
+				errs[key] = err
+			}
+			return results, errs
+		}
+		return results, nil
+	}
+}
+
+// wrapFetchFuncErr adapts a FetchFuncErr to the internal fetchFunc, ignoring
+// context.
+func wrapFetchFuncErr[K comparable, V any](fn FetchFuncErr[K, V]) fetchFunc[K, V] {
+	return func(_ context.Context, keys []K) (map[K]V, map[K]error) {
+		return fn(keys)
+	}
+}
+
+// wrapFetchFuncCtx adapts a FetchFuncCtx to the internal fetchFunc by
+// attributing a batch-wide error to every key that was requested.
+func wrapFetchFuncCtx[K comparable, V any](fn FetchFuncCtx[K, V]) fetchFunc[K, V] {
+	return func(ctx context.Context, keys []K) (map[K]V, map[K]error) {
+		results, err := fn(ctx, keys)
+		if err != nil {
+			errs := make(map[K]error, len(keys))
+			for _, key := range keys {
+				errs[key] = err
+			}
+			return results, errs
+		}
+		return results, nil
+	}
 }
 
-// Load returns the value V for key K, as determined by the fetch function
+// Load returns the value V for key K, as determined by the fetch function. If
+// the fetch function reported an error for this specific key, that error is
+// returned.
 func (loader *Loader[K, V]) Load(key K) (V, error) {
+	return loader.LoadThunk(key)()
+}
+
+// LoadMany returns a map of values V for keys K, as determined by the fetch
+// function, along with a map of errors for any keys that failed. Keys that
+// succeeded are present in results even if other keys in the same batch
+// failed.
+func (loader *Loader[K, V]) LoadMany(keys ...K) (map[K]V, map[K]error) {
+	return loader.LoadManyThunk(keys...)()
+}
+
+// LoadCtx is the context-aware equivalent of Load. If ctx is cancelled before
+// the batch runs, LoadCtx returns ctx.Err(); the batch is dropped without
+// calling fetch only if every caller waiting on it has cancelled too. If ctx is
+// cancelled while the fetch is already in flight, it returns ctx.Err()
+// immediately while the fetch continues for other callers in the same batch.
+func (loader *Loader[K, V]) LoadCtx(ctx context.Context, key K) (V, error) {
 	var empty V
-	results, err := loader.LoadMany(key)
-	if err != nil {
+
+	results, errs := loader.LoadManyCtx(ctx, key)
+	if err, ok := errs[key]; ok {
 		return empty, err
 	}
 
 	return results[key], nil
 }
 
-// LoadMany returns a map of values V for keys K, as determined by the fetch function.
-func (loader *Loader[K, V]) LoadMany(keys ...K) (map[K]V, error) {
-	loader.mut.Lock()
-	if loader.currentBatch == nil {
-		loader.currentBatch = &batch[K, V]{
-			batchSize: loader.BatchSize,
-			keys:      make(map[K]bool),
-			results:   make(map[K]V),
-			ch:        make(chan bool),
-			fn:        loader.fn,
+// LoadManyCtx is the context-aware equivalent of LoadMany.
+func (loader *Loader[K, V]) LoadManyCtx(ctx context.Context, keys ...K) (map[K]V, map[K]error) {
+	cached, missing := loader.checkCache(keys)
+	results := make(map[K]V, len(keys))
+	for key, value := range cached {
+		results[key] = value
+	}
+
+	if len(missing) == 0 {
+		return results, nil
+	}
+
+	assignments := loader.enqueueCtx(ctx, missing...)
+
+	type outcome struct {
+		results map[K]V
+		errs    map[K]error
+	}
+
+	done := make(chan outcome, 1)
+	go func() {
+		resolved, errs := loader.resolve(assignments)
+		done <- outcome{resolved, errs}
+	}()
+
+	select {
+	case o := <-done:
+		for key, value := range o.results {
+			results[key] = value
 		}
-		go loader.run()
+		return results, o.errs
+	case <-ctx.Done():
+		errs := make(map[K]error, len(missing))
+		for _, key := range missing {
+			errs[key] = ctx.Err()
+		}
+		return results, errs
 	}
+}
 
-	bat := loader.currentBatch
-	loader.mut.Unlock()
+// LoadThunk enqueues key into the current batch and returns immediately with a
+// closure that, when invoked, blocks until the batch has run and returns the
+// value (and, if the fetch function reported one, the error) for that key.
+//
+// This lets a caller schedule several loads - across independent fields of a
+// GraphQL selection set, say - into a single batch window before resolving
+// any of them, rather than spawning one goroutine per Load to get the same
+// effect.
+func (loader *Loader[K, V]) LoadThunk(key K) func() (V, error) {
+	if loader.cachingEnabled() {
+		if value, ok := loader.cacheGet(key); ok {
+			return func() (V, error) { return value, nil }
+		}
+	}
 
-	bat.mut.Lock()
-	for _, key := range keys {
-		bat.keys[key] = true
+	assignments := loader.enqueueCtx(context.Background(), key)
+
+	return func() (V, error) {
+		var empty V
+
+		results, errs := loader.resolve(assignments)
+		if err, ok := errs[key]; ok {
+			return empty, err
+		}
+
+		return results[key], nil
 	}
+}
 
-	ch := bat.ch
-	bat.mut.Unlock()
+// LoadManyThunk is the LoadMany equivalent of LoadThunk: it checks the cache,
+// enqueues cache-missed keys into the current batch, and returns a closure
+// that blocks until the batch has run.
+func (loader *Loader[K, V]) LoadManyThunk(keys ...K) func() (map[K]V, map[K]error) {
+	cached, missing := loader.checkCache(keys)
 
-	<-ch
+	var assignments []keyBatch[K, V]
+	if len(missing) > 0 {
+		assignments = loader.enqueueCtx(context.Background(), missing...)
+	}
 
-	bat.mut.RLock()
-	results := make(map[K]V)
-	if bat.err != nil {
-		return results, bat.err
+	return func() (map[K]V, map[K]error) {
+		results := make(map[K]V, len(keys))
+		for key, value := range cached {
+			results[key] = value
+		}
+
+		var errs map[K]error
+		if assignments != nil {
+			var resolved map[K]V
+			resolved, errs = loader.resolve(assignments)
+			for key, value := range resolved {
+				results[key] = value
+			}
+		}
+
+		return results, errs
 	}
+}
 
-	for _, key := range keys {
-		results[key] = bat.results[key]
+// keyBatch pairs a batch with the subset of a caller's keys that ended up
+// enqueued onto it. A single enqueueCtx call spans more than one keyBatch
+// when MaxBatch forces its keys to spill across batch windows.
+type keyBatch[K comparable, V any] struct {
+	bat  *batch[K, V]
+	keys []K
+}
+
+// enqueueCtx adds keys to the current batch, creating one (and starting its
+// run loop) if none is currently open, and returns the batches keys ended up
+// on. ctx registers the caller as a waiter on each such batch: if ctx is
+// cancellable and every such waiter on a batch cancels before the Delay
+// window expires, that batch is dropped without calling fetch.
+//
+// If MaxBatch is set, keys beyond what the current batch has room for spill
+// into a freshly created one - even within this single call - so a MaxBatch
+// of 3 handed 10 keys in one LoadMany never grows one batch past 3.
+func (loader *Loader[K, V]) enqueueCtx(ctx context.Context, keys ...K) []keyBatch[K, V] {
+	var assignments []keyBatch[K, V]
+
+	remaining := keys
+	for len(remaining) > 0 {
+		loader.mut.Lock()
+		if loader.currentBatch != nil && loader.MaxBatch > 0 && loader.currentBatch.full(loader.MaxBatch) {
+			// this batch has already accepted as many keys as it's allowed to;
+			// further keys start a fresh batch rather than piling onto it.
+			loader.currentBatch = nil
+		}
+		if loader.currentBatch == nil {
+			bctx, cancel := context.WithCancel(context.Background())
+			loader.currentBatch = &batch[K, V]{
+				batchSize: loader.BatchSize,
+				keys:      make(map[K]bool),
+				results:   make(map[K]V),
+				errs:      make(map[K]error),
+				ch:        make(chan bool),
+				fn:        loader.fn,
+				ctx:       bctx,
+				cancel:    cancel,
+			}
+			go loader.run(loader.currentBatch)
+		}
+
+		bat := loader.currentBatch
+		loader.mut.Unlock()
+
+		bat.mut.Lock()
+		var accepted []K
+		for len(remaining) > 0 {
+			key := remaining[0]
+			if _, already := bat.keys[key]; !already && loader.MaxBatch > 0 && len(bat.keys) >= loader.MaxBatch {
+				// this batch is full; whatever's left spills into a new one.
+				break
+			}
+			bat.keys[key] = true
+			accepted = append(accepted, key)
+			remaining = remaining[1:]
+		}
+		bat.waiters++
+		bat.mut.Unlock()
+
+		if ctx.Done() != nil {
+			go func() {
+				select {
+				case <-ctx.Done():
+					bat.mut.Lock()
+					bat.waiters--
+					if bat.waiters == 0 {
+						bat.cancel()
+					}
+					bat.mut.Unlock()
+				case <-bat.ch:
+				}
+			}()
+		}
+
+		assignments = append(assignments, keyBatch[K, V]{bat: bat, keys: accepted})
 	}
-	bat.mut.RUnlock()
 
-	return results, nil
+	return assignments
 }
 
-func (loader *Loader[K, V]) run() {
-	time.Sleep(loader.Delay)
+// resolve waits on every batch in assignments and merges each one's results
+// and errors, keyed by only the keys the caller actually had on that batch.
+// Results that came back present in the batch (as opposed to a defaulted
+// zero value for a key the fetch never returned) are cached.
+func (loader *Loader[K, V]) resolve(assignments []keyBatch[K, V]) (map[K]V, map[K]error) {
+	results := make(map[K]V)
+
+	var errs map[K]error
+
+	for _, assignment := range assignments {
+		<-assignment.bat.ch
+
+		assignment.bat.mut.RLock()
+		for _, key := range assignment.keys {
+			if err, ok := assignment.bat.errs[key]; ok {
+				if errs == nil {
+					errs = make(map[K]error)
+				}
+				errs[key] = err
+				continue
+			}
+
+			value, ok := assignment.bat.results[key]
+			results[key] = value
+			if ok && loader.cachingEnabled() {
+				loader.cacheSet(key, value)
+			}
+		}
+		assignment.bat.mut.RUnlock()
+	}
+
+	return results, errs
+}
+
+func (loader *Loader[K, V]) run(bat *batch[K, V]) {
+	select {
+	case <-time.After(loader.Delay):
+	case <-bat.ctx.Done():
+	}
 
 	loader.mut.Lock()
-	bat := loader.currentBatch
-	loader.currentBatch = nil
+	if loader.currentBatch == bat {
+		loader.currentBatch = nil
+	}
 	loader.mut.Unlock()
 
 	bat.mut.Lock()
 	defer bat.mut.Unlock()
 
+	if bat.ctx.Err() != nil {
+		// every waiter cancelled before the delay expired; nobody is left to
+		// see the results, so drop the batch without calling fetch.
+		close(bat.ch)
+		return
+	}
+
 	keys := make([]K, 0)
 	for key := range bat.keys {
 		keys = append(keys, key)
 	}
 
+	type chunkResult struct {
+		results map[K]V
+		errs    map[K]error
+	}
+
 	var (
 		chunks     = chunk(keys, bat.batchSize)
 		wgChan     = make(chan bool)
-		errChan    = make(chan error)
-		resultChan = make(chan map[K]V)
+		resultChan = make(chan chunkResult)
 	)
 
+	// sem, if set, bounds how many chunks are in flight against fetch at once -
+	// without it, a batch with enough keys to split into many chunks would fire
+	// every chunk's fetch concurrently, which can trivially exceed a downstream
+	// connection pool.
+	var sem chan struct{}
+	if loader.MaxConcurrency > 0 {
+		sem = make(chan struct{}, loader.MaxConcurrency)
+	}
+
 	go func() {
 		var wg sync.WaitGroup
 		for idx := range chunks {
 			wg.Add(1)
 			go func(chunk []K) {
 				defer wg.Done()
-				results, err := bat.fn(chunk)
-				if err != nil {
-					errChan <- err
-					return
+				if sem != nil {
+					sem <- struct{}{}
+					defer func() { <-sem }()
 				}
-				resultChan <- results
+				results, errs := bat.fn(bat.ctx, chunk)
+				resultChan <- chunkResult{results: results, errs: errs}
 			}(chunks[idx])
 		}
 
@@ -235,13 +727,13 @@ func (loader *Loader[K, V]) run() {
 loop:
 	for {
 		select {
-		case results := <-resultChan:
-			for key := range results {
-				bat.results[key] = results[key]
+		case cr := <-resultChan:
+			for key := range cr.results {
+				bat.results[key] = cr.results[key]
+			}
+			for key := range cr.errs {
+				bat.errs[key] = cr.errs[key]
 			}
-		case err := <-errChan:
-			bat.err = err
-			break loop
 		case <-wgChan:
 			break loop
 		}
@@ -257,3 +749,26 @@ func chunk[T any](items []T, size int) [][]T {
 	}
 	return append(chunks, items)
 }
+
+// SliceFetchFunc is the fetch signature for a SliceLoader: each key maps to a
+// slice of zero or more values, for one-to-many relationships like
+// User.posts or Ticket.comments.
+type SliceFetchFunc[K comparable, V any] func(keys []K) (map[K][]V, error)
+
+// SliceLoader is the one-to-many sibling of Loader: Load returns a slice of
+// values for a key instead of a single value. It's a Loader[K, []V] under the
+// hood, so batching, deduplication, chunking, caching, MaxBatch and
+// MaxConcurrency are all shared with Loader rather than reimplemented.
+type SliceLoader[K comparable, V any] struct {
+	*Loader[K, []V]
+}
+
+// NewSlice generates a new SliceLoader with default BatchSize and Delay.
+func NewSlice[K comparable, V any](fn SliceFetchFunc[K, V]) (*SliceLoader[K, V], error) {
+	loader, err := New[K, []V](FetchFunc[K, []V](fn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SliceLoader[K, V]{Loader: loader}, nil
+}
@@ -1,8 +1,10 @@
 package dataloader_test
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -81,6 +83,287 @@ func TestLoadMany(t *testing.T) {
 	wg.Wait()
 }
 
+func fetchErr(keys []string) (map[string]string, map[string]error) {
+	results := make(map[string]string)
+	errs := make(map[string]error)
+	for idx := range keys {
+		key := keys[idx]
+		if key == "bad" {
+			errs[key] = fmt.Errorf("no such record: %s", key)
+			continue
+		}
+		results[key] = "result " + key
+	}
+	return results, errs
+}
+
+func TestLoadManyPerKeyErrors(t *testing.T) {
+	loader, err := dataloader.NewWithErrors(fetchErr)
+	require.Nil(t, err)
+	require.NotNil(t, loader)
+
+	results, errs := loader.LoadMany("good", "bad")
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs["bad"], "no such record: bad")
+
+	assert.Equal(t, "result good", results["good"])
+	_, ok := results["bad"]
+	assert.False(t, ok)
+}
+
+func TestLoadPerKeyError(t *testing.T) {
+	loader, err := dataloader.NewWithErrors(fetchErr)
+	require.Nil(t, err)
+	require.NotNil(t, loader)
+
+	_, err = loader.Load("bad")
+	require.Error(t, err)
+	assert.EqualError(t, err, "no such record: bad")
+
+	result, err := loader.Load("good")
+	require.Nil(t, err)
+	assert.Equal(t, "result good", result)
+}
+
+func TestLoadThunk(t *testing.T) {
+	loader, err := dataloader.New(fetch)
+	require.Nil(t, err)
+	require.NotNil(t, loader)
+
+	thunks := make([]func() (string, error), 0)
+	keys := make([]string, 0)
+
+	for i := 0; i < 10; i++ {
+		key := fmt.Sprintf("thunk %d", i)
+		keys = append(keys, key)
+		thunks = append(thunks, loader.LoadThunk(key))
+	}
+
+	for idx, thunk := range thunks {
+		result, err := thunk()
+		require.Nil(t, err)
+		assert.Equal(t, "result "+keys[idx], result)
+	}
+}
+
+func TestLoadManyThunk(t *testing.T) {
+	loader, err := dataloader.New(fetch)
+	require.Nil(t, err)
+	require.NotNil(t, loader)
+
+	thunk := loader.LoadManyThunk("thunkMany 1", "thunkMany 2")
+
+	results, errs := thunk()
+	require.Empty(t, errs)
+	assert.Equal(t, "result thunkMany 1", results["thunkMany 1"])
+	assert.Equal(t, "result thunkMany 2", results["thunkMany 2"])
+}
+
+func TestCache(t *testing.T) {
+	var fetchCount int32
+
+	countingFetch := func(keys []string) (map[string]string, error) {
+		atomic.AddInt32(&fetchCount, 1)
+		return fetch(keys)
+	}
+
+	loader, err := dataloader.New(countingFetch)
+	require.Nil(t, err)
+	loader.CacheTTL = time.Hour
+
+	result, err := loader.Load("cached")
+	require.Nil(t, err)
+	assert.Equal(t, "result cached", result)
+
+	// second load for the same key, in a separate batch window, should be
+	// served from the cache rather than hitting fetch again
+	time.Sleep(10 * time.Millisecond)
+	result, err = loader.Load("cached")
+	require.Nil(t, err)
+	assert.Equal(t, "result cached", result)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetchCount))
+
+	loader.Clear("cached")
+	time.Sleep(10 * time.Millisecond)
+	_, err = loader.Load("cached")
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetchCount))
+}
+
+func TestCacheDoesNotMemoizeAbsentKeys(t *testing.T) {
+	var fetchCount int32
+
+	// fetch only ever returns "known", so "unknown" is absent from every
+	// fetch result rather than erroring.
+	partialFetch := func(keys []string) (map[string]string, error) {
+		atomic.AddInt32(&fetchCount, 1)
+		results := make(map[string]string)
+		for _, key := range keys {
+			if key == "known" {
+				results[key] = "result known"
+			}
+		}
+		return results, nil
+	}
+
+	loader, err := dataloader.New(partialFetch)
+	require.Nil(t, err)
+	loader.CacheTTL = time.Hour
+
+	result, err := loader.Load("unknown")
+	require.Nil(t, err)
+	assert.Equal(t, "", result)
+
+	// an absent key is not a successful result, so it must not be cached -
+	// the next Load should hit fetch again rather than replaying the zero value
+	time.Sleep(10 * time.Millisecond)
+	result, err = loader.Load("unknown")
+	require.Nil(t, err)
+	assert.Equal(t, "", result)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetchCount))
+}
+
+func TestCachePrime(t *testing.T) {
+	loader, err := dataloader.New(fetch)
+	require.Nil(t, err)
+	loader.CacheTTL = time.Hour
+
+	loader.Prime("primed", "precomputed value")
+
+	result, err := loader.Load("primed")
+	require.Nil(t, err)
+	assert.Equal(t, "precomputed value", result)
+
+	loader.ClearAll()
+	result, err = loader.Load("primed")
+	require.Nil(t, err)
+	assert.Equal(t, "result primed", result)
+}
+
+func TestLoadCtx(t *testing.T) {
+	loader, err := dataloader.New(fetch)
+	require.Nil(t, err)
+
+	result, err := loader.LoadCtx(context.Background(), "ctxKey")
+	require.Nil(t, err)
+	assert.Equal(t, "result ctxKey", result)
+}
+
+func TestLoadManyCtx(t *testing.T) {
+	loader, err := dataloader.New(fetch)
+	require.Nil(t, err)
+
+	results, errs := loader.LoadManyCtx(context.Background(), "ctxMany 1", "ctxMany 2")
+	require.Empty(t, errs)
+	assert.Equal(t, "result ctxMany 1", results["ctxMany 1"])
+	assert.Equal(t, "result ctxMany 2", results["ctxMany 2"])
+}
+
+func TestLoadCtxCancelledBeforeDelay(t *testing.T) {
+	var fetchCalled int32
+
+	loader, err := dataloader.New(func(keys []string) (map[string]string, error) {
+		atomic.AddInt32(&fetchCalled, 1)
+		return fetch(keys)
+	})
+	require.Nil(t, err)
+	loader.Delay = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = loader.LoadCtx(ctx, "cancelled")
+	require.ErrorIs(t, err, context.Canceled)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&fetchCalled))
+}
+
+func TestMaxConcurrency(t *testing.T) {
+	var (
+		inFlight int32
+		maxSeen  int32
+	)
+
+	slowFetch := func(keys []string) (map[string]string, error) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			seen := atomic.LoadInt32(&maxSeen)
+			if cur <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, cur) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		return fetch(keys)
+	}
+
+	loader, err := dataloader.New(slowFetch)
+	require.Nil(t, err)
+	loader.BatchSize = 1
+	loader.MaxConcurrency = 2
+
+	keys := make([]string, 0)
+	for i := 0; i < 10; i++ {
+		keys = append(keys, fmt.Sprintf("concurrency %d", i))
+	}
+
+	_, errs := loader.LoadMany(keys...)
+	require.Empty(t, errs)
+
+	// maxSeen must land exactly on the cap: <= 2 alone would also pass if the
+	// semaphore over-throttled everything down to serial, or were dropped
+	// and just got lucky with scheduling.
+	assert.Equal(t, 2, int(atomic.LoadInt32(&maxSeen)))
+}
+
+func TestMaxBatch(t *testing.T) {
+	loader, err := dataloader.New(fetch)
+	require.Nil(t, err)
+	loader.MaxBatch = 1
+
+	thunkA := loader.LoadThunk("batchA")
+	thunkB := loader.LoadThunk("batchB")
+
+	resultA, err := thunkA()
+	require.Nil(t, err)
+	assert.Equal(t, "result batchA", resultA)
+
+	resultB, err := thunkB()
+	require.Nil(t, err)
+	assert.Equal(t, "result batchB", resultB)
+}
+
+func fetchPosts(userIDs []int) (map[int][]string, error) {
+	results := make(map[int][]string)
+	for _, id := range userIDs {
+		results[id] = []string{
+			fmt.Sprintf("post %d-1", id),
+			fmt.Sprintf("post %d-2", id),
+		}
+	}
+	return results, nil
+}
+
+func TestSliceLoader(t *testing.T) {
+	loader, err := dataloader.NewSlice(fetchPosts)
+	require.Nil(t, err)
+	require.NotNil(t, loader)
+
+	posts, err := loader.Load(1)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"post 1-1", "post 1-2"}, posts)
+
+	many, errs := loader.LoadMany(1, 2)
+	require.Empty(t, errs)
+	assert.Equal(t, []string{"post 1-1", "post 1-2"}, many[1])
+	assert.Equal(t, []string{"post 2-1", "post 2-2"}, many[2])
+}
+
 func TestForceBatches(t *testing.T) {
 	loader, err := dataloader.New(fetch)
 	require.Nil(t, err)